@@ -3,9 +3,10 @@ package adyen
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -16,12 +17,31 @@ const DefaultCurrency = "EUR"
 // Version of a current Adyen API
 const (
 	APIVersion = "v25"
+
+	// CheckoutAPIVersion is the API version used for Checkout API requests.
+	// It evolves independently from the classic PAL APIVersion.
+	CheckoutAPIVersion = "v71"
+
+	// BinLookupAPIVersion is the API version used for BinLookup service requests
+	BinLookupAPIVersion = "v54"
+
+	// PayoutAPIVersion is the API version used for Payout service requests
+	PayoutAPIVersion = "v68"
 )
 
 // Enpoint service to use
 const (
 	PaymentService   = "Payment"
 	RecurringService = "Recurring"
+
+	// CheckoutService is the Checkout API (Drop-in / Components) endpoint
+	CheckoutService = "checkout"
+
+	// BinLookupService is the BinLookup service endpoint
+	BinLookupService = "BinLookup"
+
+	// PayoutService is the Payout service endpoint
+	PayoutService = "Payout"
 )
 
 // New - creates Adyen instance
@@ -31,13 +51,22 @@ const (
 //     - env - Environment for next API calls
 //     - username - API username for authentication
 //     - password - API password for authentication
+//     - logger - optional logger instance, can be nil
+//     - opts - functional options, e.g. WithTimeout, WithCurrency, WithLiveURLPrefix
 //
 // You can create new API user there: https://ca-test.adyen.com/ca/ca/config/users.shtml
-func New(env environment, username, password string) *Adyen {
-	return &Adyen{
+func New(env environment, username, password string, logger *log.Logger, opts ...Option) *Adyen {
+	a := &Adyen{
 		Credentials: newCredentials(env, username, password),
 		Currency:    DefaultCurrency,
+		Logger:      logger,
+	}
+
+	for _, opt := range opts {
+		opt(a)
 	}
+
+	return a
 }
 
 // NewWithHMAC - create new Adyen instance with HPP credentials
@@ -50,13 +79,48 @@ func New(env environment, username, password string) *Adyen {
 //     - username - API username for authentication
 //     - password - API password for authentication
 //     - hmac - is generated when new Skin is created in Adyen Customer Area
+//     - logger - optional logger instance, can be nil
+//     - opts - functional options, e.g. WithTimeout, WithCurrency, WithLiveURLPrefix
 //
 // New skin can be created there https://ca-test.adyen.com/ca/ca/skin/skins.shtml
-func NewWithHMAC(env environment, username, password, hmac string) *Adyen {
-	return &Adyen{
+func NewWithHMAC(env environment, username, password, hmac string, logger *log.Logger, opts ...Option) *Adyen {
+	a := &Adyen{
 		Credentials: newCredentialsWithHMAC(env, username, password, hmac),
 		Currency:    DefaultCurrency,
+		Logger:      logger,
 	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+// NewWithAPIKey - creates Adyen instance authenticated with an API key
+//
+// Use this constructor to authenticate via the X-API-Key header instead of Basic Auth.
+//
+// Description:
+//
+//     - env - Environment for next API calls
+//     - apiKey - API key for authentication
+//     - logger - optional logger instance, can be nil
+//     - opts - functional options, e.g. WithLiveURLPrefix
+//
+// API keys can be generated there: https://ca-test.adyen.com/ca/ca/config/users.shtml
+func NewWithAPIKey(env environment, apiKey string, logger *log.Logger, opts ...Option) *Adyen {
+	a := &Adyen{
+		Credentials: newCredentialsWithAPIKey(env, apiKey),
+		Currency:    DefaultCurrency,
+		Logger:      logger,
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
 }
 
 // Adyen - base structure with configuration options
@@ -65,6 +129,9 @@ func NewWithHMAC(env environment, username, password, hmac string) *Adyen {
 //       - Currency is a default request currency. Request data overrides this setting
 //       - MerchantAccount is default merchant account to be used. Request data overrides this setting
 //       - Logger is an optional logger instance
+//       - ClientTimeout is used to build the default HTTPClient when one isn't set via WithHTTPClient
+//       - HTTPClient performs the underlying HTTP requests; defaults to one built from ClientTimeout
+//       - MaxRetries caps the total number of attempts (first try included) for idempotency-keyed requests on 429/5xx; defaults to DefaultMaxRetries
 //
 // Currency and MerchantAccount should be used only to store the data and be able to use it later.
 // Requests won't be automatically populated with given values
@@ -73,6 +140,9 @@ type Adyen struct {
 	Currency        string
 	MerchantAccount string
 	Logger          *log.Logger
+	ClientTimeout   time.Duration
+	HTTPClient      *http.Client
+	MaxRetries      int
 }
 
 // ClientURL - returns URl, that need to loaded in UI, to encrypt Credit Card information
@@ -84,7 +154,18 @@ func (a *Adyen) ClientURL(clientID string) string {
 
 // adyenURL returns Adyen backend URL
 func (a *Adyen) adyenURL(service string, requestType string) string {
-	return a.Credentials.Env.BaseURL(service, APIVersion) + "/" + requestType + "/"
+	return a.adyenURLWithVersion(service, APIVersion, requestType)
+}
+
+// adyenURLWithVersion returns Adyen backend URL for a service pinned to a specific API
+// version, used by services that evolve independently of APIVersion (BinLookup, Payout)
+func (a *Adyen) adyenURLWithVersion(service string, version string, requestType string) string {
+	return a.Credentials.Env.BaseURL(service, version, a.Credentials.LiveURLPrefix) + "/" + requestType + "/"
+}
+
+// checkoutURL returns Adyen Checkout API URL
+func (a *Adyen) checkoutURL(requestType string) string {
+	return a.Credentials.Env.CheckoutURL(CheckoutAPIVersion) + "/" + requestType
 }
 
 // createHPPUrl returns Adyen HPP url
@@ -128,58 +209,58 @@ func (a *Adyen) SetMerchantAccount(account string) {
 // internal method to do a request to Adyen API endpoint
 // request Type: POST, request body format - JSON
 func (a *Adyen) execute(service string, method string, requestEntity interface{}) (*Response, error) {
-	body, err := json.Marshal(requestEntity)
-	if err != nil {
-		return nil, err
-	}
-
-	url := a.adyenURL(service, method)
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
-	}
-
-	if a.Logger != nil {
-		a.Logger.Printf("[Request]: %s %s\n%s", method, url, body)
-	}
+	return a.executeCtx(context.Background(), service, method, requestEntity)
+}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.SetBasicAuth(a.Credentials.Username, a.Credentials.Password)
+// executeCtx is the context-aware variant of execute
+func (a *Adyen) executeCtx(ctx context.Context, service string, method string, requestEntity interface{}) (*Response, error) {
+	return a.doRequest(ctx, a.adyenURL(service, method), method, requestEntity, "", nil)
+}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+// executeVersionCtx behaves like executeCtx but targets a service pinned to a specific
+// API version, used by BinLookup and Payout
+func (a *Adyen) executeVersionCtx(ctx context.Context, service string, version string, method string, requestEntity interface{}) (*Response, error) {
+	return a.doRequest(ctx, a.adyenURLWithVersion(service, version, method), method, requestEntity, "", nil)
+}
 
-	if err != nil {
-		return nil, err
+// executePayoutCtx behaves like executeVersionCtx but authenticates with the Payout
+// service's separate review-user credentials (falling back to the main Basic Auth
+// credentials, or to the main Credentials.APIKey when neither is configured)
+func (a *Adyen) executePayoutCtx(ctx context.Context, version string, method string, requestEntity interface{}) (*Response, error) {
+	var auth *basicAuth
+	if username, password, ok := a.Credentials.payoutAuth(); ok {
+		auth = &basicAuth{Username: username, Password: password}
 	}
+	return a.doRequest(ctx, a.adyenURLWithVersion(PayoutService, version, method), method, requestEntity, "", auth)
+}
 
-	defer func() {
-		err = resp.Body.Close()
-	}()
-
-	buf := new(bytes.Buffer)
-	_, err = buf.ReadFrom(resp.Body)
+// executeIdempotent behaves like execute but attaches an auto-generated Idempotency-Key
+// header, so the request is safe for doRequest to retry on 429/5xx
+func (a *Adyen) executeIdempotent(service string, method string, requestEntity interface{}) (*Response, error) {
+	return a.executeIdempotentCtx(context.Background(), service, method, requestEntity)
+}
 
+// executeIdempotentCtx is the context-aware variant of executeIdempotent
+func (a *Adyen) executeIdempotentCtx(ctx context.Context, service string, method string, requestEntity interface{}) (*Response, error) {
+	key, err := newIdempotencyKey()
 	if err != nil {
 		return nil, err
 	}
 
-	if a.Logger != nil {
-		a.Logger.Printf("[Response]: %s %s\n%s", method, url, buf.String())
-	}
-
-	providerResponse := &Response{
-		Response: resp,
-		Body:     buf.Bytes(),
-	}
-
-	err = providerResponse.handleHTTPError()
+	return a.doRequest(ctx, a.adyenURL(service, method), method, requestEntity, key, nil)
+}
 
-	if err != nil {
-		return nil, err
-	}
+// executeCheckout requests the Adyen Checkout API
+//
+// internal method to do a request to the Checkout API endpoint, reusing the same
+// request/response pipeline as execute but against CheckoutURL instead of BaseURL
+func (a *Adyen) executeCheckout(requestType string, requestEntity interface{}) (*Response, error) {
+	return a.executeCheckoutCtx(context.Background(), requestType, requestEntity)
+}
 
-	return providerResponse, nil
+// executeCheckoutCtx is the context-aware variant of executeCheckout
+func (a *Adyen) executeCheckoutCtx(ctx context.Context, requestType string, requestEntity interface{}) (*Response, error) {
+	return a.doRequest(ctx, a.checkoutURL(requestType), requestType, requestEntity, "", nil)
 }
 
 // executeHpp - execute request without authorization to Adyen Hosted Payment API
@@ -200,8 +281,7 @@ func (a *Adyen) executeHpp(method string, requestEntity interface{}) (*Response,
 		a.Logger.Printf("[Request]: %s %s", method, url)
 	}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := a.httpClient().Do(req)
 
 	if err != nil {
 		return nil, err
@@ -244,3 +324,18 @@ func (a *Adyen) Modification() *ModificationGateway {
 func (a *Adyen) Recurring() *RecurringGateway {
 	return &RecurringGateway{a}
 }
+
+// Checkout - returns CheckoutGateway
+func (a *Adyen) Checkout() *CheckoutGateway {
+	return &CheckoutGateway{a}
+}
+
+// BinLookup - returns BinLookupGateway
+func (a *Adyen) BinLookup() *BinLookupGateway {
+	return &BinLookupGateway{a}
+}
+
+// Payout - returns PayoutGateway
+func (a *Adyen) Payout() *PayoutGateway {
+	return &PayoutGateway{a}
+}
@@ -0,0 +1,75 @@
+package adyen
+
+import "context"
+
+// BinLookupGateway exposes the BinLookup service, used to probe a card's 3DS
+// availability and brand before starting a transaction
+type BinLookupGateway struct {
+	adyen *Adyen
+}
+
+// Get3DSAvailabilityRequest is the payload for BinLookupGateway.Get3DSAvailability
+type Get3DSAvailabilityRequest struct {
+	MerchantAccount  string `json:"merchantAccount"`
+	CardNumber       string `json:"cardNumber"`
+	ShopperReference string `json:"shopperReference,omitempty"`
+}
+
+// Get3DSAvailabilityResult is the response of BinLookupGateway.Get3DSAvailability
+type Get3DSAvailabilityResult struct {
+	Is3DSupported      bool   `json:"is3DSupported"`
+	IssuingCountryCode string `json:"issuingCountryCode,omitempty"`
+}
+
+// Get3DSAvailability checks whether a card is enrolled for 3D Secure
+func (g *BinLookupGateway) Get3DSAvailability(req Get3DSAvailabilityRequest) (*Get3DSAvailabilityResult, error) {
+	return g.Get3DSAvailabilityCtx(context.Background(), req)
+}
+
+// Get3DSAvailabilityCtx is the context-aware variant of Get3DSAvailability
+func (g *BinLookupGateway) Get3DSAvailabilityCtx(ctx context.Context, req Get3DSAvailabilityRequest) (*Get3DSAvailabilityResult, error) {
+	resp, err := g.adyen.executeVersionCtx(ctx, BinLookupService, BinLookupAPIVersion, "get3dsAvailability", req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Get3DSAvailabilityResult{}
+	if err := resp.Unmarshal(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetCostEstimateRequest is the payload for BinLookupGateway.GetCostEstimate
+type GetCostEstimateRequest struct {
+	MerchantAccount string `json:"merchantAccount"`
+	CardNumber      string `json:"cardNumber"`
+	Amount          Amount `json:"amount"`
+}
+
+// GetCostEstimateResult is the response of BinLookupGateway.GetCostEstimate
+type GetCostEstimateResult struct {
+	ResultCode   string `json:"resultCode"`
+	CostEstimate Amount `json:"costEstimate,omitempty"`
+}
+
+// GetCostEstimate estimates the interchange cost of processing a transaction for a card
+func (g *BinLookupGateway) GetCostEstimate(req GetCostEstimateRequest) (*GetCostEstimateResult, error) {
+	return g.GetCostEstimateCtx(context.Background(), req)
+}
+
+// GetCostEstimateCtx is the context-aware variant of GetCostEstimate
+func (g *BinLookupGateway) GetCostEstimateCtx(ctx context.Context, req GetCostEstimateRequest) (*GetCostEstimateResult, error) {
+	resp, err := g.adyen.executeVersionCtx(ctx, BinLookupService, BinLookupAPIVersion, "getCostEstimate", req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GetCostEstimateResult{}
+	if err := resp.Unmarshal(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,146 @@
+package adyen
+
+import "context"
+
+// CheckoutGateway exposes the Checkout API (Drop-in / Components) operations
+type CheckoutGateway struct {
+	adyen *Adyen
+}
+
+// PaymentMethodsRequest is the payload for CheckoutGateway.PaymentMethods
+type PaymentMethodsRequest struct {
+	MerchantAccount string  `json:"merchantAccount"`
+	CountryCode     string  `json:"countryCode,omitempty"`
+	ShopperLocale   string  `json:"shopperLocale,omitempty"`
+	Amount          *Amount `json:"amount,omitempty"`
+}
+
+// PaymentMethodsResponse is the response of CheckoutGateway.PaymentMethods
+type PaymentMethodsResponse struct {
+	PaymentMethods []struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"paymentMethods"`
+}
+
+// PaymentMethods lists the payment methods available to a shopper
+func (g *CheckoutGateway) PaymentMethods(req PaymentMethodsRequest) (*PaymentMethodsResponse, error) {
+	return g.PaymentMethodsCtx(context.Background(), req)
+}
+
+// PaymentMethodsCtx is the context-aware variant of PaymentMethods
+func (g *CheckoutGateway) PaymentMethodsCtx(ctx context.Context, req PaymentMethodsRequest) (*PaymentMethodsResponse, error) {
+	resp, err := g.adyen.executeCheckoutCtx(ctx, "paymentMethods", req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PaymentMethodsResponse{}
+	if err := resp.Unmarshal(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// PaymentsRequest is the payload for CheckoutGateway.Payments
+type PaymentsRequest struct {
+	MerchantAccount string                 `json:"merchantAccount"`
+	Amount          Amount                 `json:"amount"`
+	Reference       string                 `json:"reference"`
+	PaymentMethod   map[string]interface{} `json:"paymentMethod"`
+	ReturnURL       string                 `json:"returnUrl,omitempty"`
+}
+
+// PaymentsResponse is the response of CheckoutGateway.Payments
+type PaymentsResponse struct {
+	PspReference string                 `json:"pspReference"`
+	ResultCode   string                 `json:"resultCode"`
+	Action       map[string]interface{} `json:"action,omitempty"`
+}
+
+// Payments submits a Checkout API payment
+func (g *CheckoutGateway) Payments(req PaymentsRequest) (*PaymentsResponse, error) {
+	return g.PaymentsCtx(context.Background(), req)
+}
+
+// PaymentsCtx is the context-aware variant of Payments
+func (g *CheckoutGateway) PaymentsCtx(ctx context.Context, req PaymentsRequest) (*PaymentsResponse, error) {
+	resp, err := g.adyen.executeCheckoutCtx(ctx, "payments", req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PaymentsResponse{}
+	if err := resp.Unmarshal(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// PaymentsDetailsRequest is the payload for CheckoutGateway.PaymentsDetails
+type PaymentsDetailsRequest struct {
+	Details     map[string]interface{} `json:"details"`
+	PaymentData string                 `json:"paymentData,omitempty"`
+}
+
+// PaymentsDetailsResponse is the response of CheckoutGateway.PaymentsDetails
+type PaymentsDetailsResponse struct {
+	PspReference string `json:"pspReference"`
+	ResultCode   string `json:"resultCode"`
+}
+
+// PaymentsDetails submits additional details requested by a previous Payments action (e.g. 3DS)
+func (g *CheckoutGateway) PaymentsDetails(req PaymentsDetailsRequest) (*PaymentsDetailsResponse, error) {
+	return g.PaymentsDetailsCtx(context.Background(), req)
+}
+
+// PaymentsDetailsCtx is the context-aware variant of PaymentsDetails
+func (g *CheckoutGateway) PaymentsDetailsCtx(ctx context.Context, req PaymentsDetailsRequest) (*PaymentsDetailsResponse, error) {
+	resp, err := g.adyen.executeCheckoutCtx(ctx, "payments/details", req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PaymentsDetailsResponse{}
+	if err := resp.Unmarshal(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SessionsRequest is the payload for CheckoutGateway.Sessions
+type SessionsRequest struct {
+	MerchantAccount string `json:"merchantAccount"`
+	Amount          Amount `json:"amount"`
+	Reference       string `json:"reference"`
+	ReturnURL       string `json:"returnUrl"`
+}
+
+// SessionsResponse is the response of CheckoutGateway.Sessions
+type SessionsResponse struct {
+	ID          string `json:"id"`
+	SessionData string `json:"sessionData"`
+}
+
+// Sessions creates a Checkout session used to start Drop-in or Components
+func (g *CheckoutGateway) Sessions(req SessionsRequest) (*SessionsResponse, error) {
+	return g.SessionsCtx(context.Background(), req)
+}
+
+// SessionsCtx is the context-aware variant of Sessions
+func (g *CheckoutGateway) SessionsCtx(ctx context.Context, req SessionsRequest) (*SessionsResponse, error) {
+	resp, err := g.adyen.executeCheckoutCtx(ctx, "sessions", req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SessionsResponse{}
+	if err := resp.Unmarshal(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
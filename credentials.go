@@ -0,0 +1,50 @@
+package adyen
+
+// apiCredentials holds the authentication details used to sign requests against the Adyen API
+type apiCredentials struct {
+	Env      environment
+	Username string
+	Password string
+	HMAC     string
+
+	// APIKey authenticates via the X-API-Key header instead of Basic Auth when set
+	APIKey string
+
+	// LiveURLPrefix is the merchant-specific prefix required to build Live environment hostnames
+	LiveURLPrefix string
+
+	// PayoutUsername and PayoutPassword authenticate Payout service calls, which require a
+	// separate review-user account. When unset, Payout calls fall back to Username/Password.
+	PayoutUsername string
+	PayoutPassword string
+}
+
+// payoutAuth returns the Basic Auth credentials used for the Payout service, falling back
+// to the main Username/Password when no PayoutUsername/PayoutPassword are configured. ok is
+// false when neither is set, which is the case for instances built with NewWithAPIKey that
+// haven't separately configured PayoutUsername/PayoutPassword — the caller should then fall
+// through to the main Credentials.APIKey instead of sending empty Basic Auth.
+func (c apiCredentials) payoutAuth() (username, password string, ok bool) {
+	if c.PayoutUsername != "" || c.PayoutPassword != "" {
+		return c.PayoutUsername, c.PayoutPassword, true
+	}
+	if c.Username != "" || c.Password != "" {
+		return c.Username, c.Password, true
+	}
+	return "", "", false
+}
+
+// newCredentials creates credentials for Basic Auth based API access
+func newCredentials(env environment, username, password string) apiCredentials {
+	return apiCredentials{Env: env, Username: username, Password: password}
+}
+
+// newCredentialsWithHMAC creates credentials for Basic Auth based API access, extended with an HPP HMAC signing key
+func newCredentialsWithHMAC(env environment, username, password, hmac string) apiCredentials {
+	return apiCredentials{Env: env, Username: username, Password: password, HMAC: hmac}
+}
+
+// newCredentialsWithAPIKey creates credentials for API-key based API access
+func newCredentialsWithAPIKey(env environment, apiKey string) apiCredentials {
+	return apiCredentials{Env: env, APIKey: apiKey}
+}
@@ -0,0 +1,74 @@
+package adyen
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPayoutAuthFallsBackToMainBasicAuth(t *testing.T) {
+	c := newCredentials(Testing, "un", "pw")
+
+	username, password, ok := c.payoutAuth()
+	if !ok {
+		t.Fatal("expected ok, main Username/Password are set")
+	}
+	equals(t, "un", username)
+	equals(t, "pw", password)
+}
+
+func TestPayoutAuthPrefersExplicitPayoutCredentials(t *testing.T) {
+	c := newCredentials(Testing, "un", "pw")
+	c.PayoutUsername = "payout-un"
+	c.PayoutPassword = "payout-pw"
+
+	username, password, ok := c.payoutAuth()
+	if !ok {
+		t.Fatal("expected ok, PayoutUsername/PayoutPassword are set")
+	}
+	equals(t, "payout-un", username)
+	equals(t, "payout-pw", password)
+}
+
+// TestPayoutAuthIsNotOKForAPIKeyCredentials is a regression test: an Adyen instance built
+// with NewWithAPIKey that hasn't separately configured PayoutUsername/PayoutPassword has no
+// Basic Auth credentials at all, so payoutAuth must report ok=false rather than falling back
+// to an empty Username/Password pair, which previously caused executePayoutCtx to silently
+// send Authorization: Basic Og== instead of the X-API-Key header.
+func TestPayoutAuthIsNotOKForAPIKeyCredentials(t *testing.T) {
+	c := newCredentialsWithAPIKey(Testing, "test-api-key")
+
+	_, _, ok := c.payoutAuth()
+	if ok {
+		t.Fatal("expected ok=false, no Basic Auth credentials are configured")
+	}
+}
+
+func TestPayoutAuthSelectionSendsAPIKeyWithoutPayoutCredentials(t *testing.T) {
+	var gotAPIKey, gotAuthHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAPIKey = r.Header.Get("X-API-Key")
+		gotAuthHeader = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	a := &Adyen{Credentials: newCredentialsWithAPIKey(Testing, "test-api-key")}
+
+	// executePayoutCtx builds its own URL from a.Credentials.Env, so exercise the same
+	// auth-selection it performs (payoutAuth -> basicAuth override, or nil) against the
+	// test server directly through doRequest.
+	var auth *basicAuth
+	if username, password, ok := a.Credentials.payoutAuth(); ok {
+		auth = &basicAuth{Username: username, Password: password}
+	}
+
+	_, err := a.doRequest(context.Background(), server.URL, "test", map[string]string{}, "", auth)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	equals(t, "test-api-key", gotAPIKey)
+	equals(t, "", gotAuthHeader)
+}
@@ -0,0 +1,51 @@
+package adyen
+
+import "fmt"
+
+// environment selects which Adyen environment API calls are sent to
+type environment int
+
+// Adyen environments
+const (
+	// Testing environment, use it for integration and development
+	Testing environment = iota
+	// Live environment, use it for production traffic
+	Live
+)
+
+// prefix returns the hostname component identifying the environment
+func (e environment) prefix() string {
+	if e == Live {
+		return "live"
+	}
+	return "test"
+}
+
+// BaseURL returns the PAL API base URL for a given service and API version.
+// liveURLPrefix is the merchant-specific prefix required on Live and is ignored on Testing.
+// Callers that never set a prefix (e.g. via WithLiveURLPrefix) fall back to the
+// unprefixed Live hostname rather than producing a malformed one.
+func (e environment) BaseURL(service, version, liveURLPrefix string) string {
+	if e == Live {
+		if liveURLPrefix == "" {
+			return fmt.Sprintf("https://pal-live.adyenpayments.com/pal/servlet/%s/%s", service, version)
+		}
+		return fmt.Sprintf("https://%s-pal-live.adyenpayments.com/pal/servlet/%s/%s", liveURLPrefix, service, version)
+	}
+	return fmt.Sprintf("https://pal-test.adyen.com/pal/servlet/%s/%s", service, version)
+}
+
+// CheckoutURL returns the Checkout API base URL for a given API version
+func (e environment) CheckoutURL(version string) string {
+	return fmt.Sprintf("https://checkout-%s.adyen.com/%s", e.prefix(), version)
+}
+
+// ClientURL returns the URL used to load Adyen's client-side encryption library
+func (e environment) ClientURL(clientID string) string {
+	return fmt.Sprintf("https://%s.adyen.com/hpp/cse/js/%s.shtml", e.prefix(), clientID)
+}
+
+// HppURL returns the Adyen Hosted Payment Pages URL for a given request type
+func (e environment) HppURL(requestType string) string {
+	return fmt.Sprintf("https://%s.adyen.com/hpp/%s.shtml", e.prefix(), requestType)
+}
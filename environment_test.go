@@ -0,0 +1,18 @@
+package adyen
+
+import "testing"
+
+func TestBaseURLTestingIgnoresLiveURLPrefix(t *testing.T) {
+	got := Testing.BaseURL(PaymentService, APIVersion, "merchant-prefix")
+	equals(t, "https://pal-test.adyen.com/pal/servlet/Payment/v25", got)
+}
+
+func TestBaseURLLiveSubstitutesPrefix(t *testing.T) {
+	got := Live.BaseURL(PaymentService, APIVersion, "merchant-prefix")
+	equals(t, "https://merchant-prefix-pal-live.adyenpayments.com/pal/servlet/Payment/v25", got)
+}
+
+func TestBaseURLLiveFallsBackToUnprefixedHostWithoutPrefix(t *testing.T) {
+	got := Live.BaseURL(PaymentService, APIVersion, "")
+	equals(t, "https://pal-live.adyenpayments.com/pal/servlet/Payment/v25", got)
+}
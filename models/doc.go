@@ -0,0 +1,24 @@
+// Package models is the intended home for per-service typed request/response structs
+// generated from Adyen's published OpenAPI specs (Payment, Recurring, Checkout, Payout,
+// BinLookup).
+//
+// The generation pipeline itself (Makefile target, templates/go, scripts) is in place:
+//
+//	make models SERVICE=checkout VERSION=v71
+//
+// runs openapi-generator-cli against the pinned templates in templates/go, strips
+// generator scaffolding (configuration.go, client.go, go.mod — utils.go is kept, since
+// it holds the generator's shared NullableX wrapper types), and normalises
+// operation method names via scripts/trim_operation_suffixes.sh. The model template
+// emits plain field types: generated structs do not use the NullableX wrappers, and no
+// per-model NullableX types are generated.
+//
+// Scope of this package, as it stands: pipeline scaffolding only. `make models` requires
+// Docker and network access to pull openapitools/openapi-generator-cli and Adyen's spec
+// files, neither of which is available in every environment this repo is built in, so
+// generation has not been run here and no service's output has been reviewed.
+// Consequently the hand-written gateways (PaymentGateway, CheckoutGateway, ...) do not
+// delegate to anything in this package yet. Generating a first service end-to-end and
+// refactoring a gateway to delegate to it is explicitly out of scope for this change and
+// should be its own follow-up, reviewed once real generated output exists to look at.
+package models
@@ -0,0 +1,42 @@
+package adyen
+
+import "context"
+
+// ModificationGateway exposes the classic PAL Modification service operations
+type ModificationGateway struct {
+	adyen *Adyen
+}
+
+// CaptureRequest is the payload for ModificationGateway.Capture
+type CaptureRequest struct {
+	MerchantAccount    string `json:"merchantAccount"`
+	OriginalReference  string `json:"originalReference"`
+	ModificationAmount Amount `json:"modificationAmount"`
+}
+
+// ModificationResult is the response of a Modification service call
+type ModificationResult struct {
+	PspReference string `json:"pspReference"`
+	Response     string `json:"response"`
+}
+
+// Capture submits a capture request for a previously authorised payment. The request
+// carries an auto-generated Idempotency-Key, so transient failures are safely retried.
+func (g *ModificationGateway) Capture(req CaptureRequest) (*ModificationResult, error) {
+	return g.CaptureCtx(context.Background(), req)
+}
+
+// CaptureCtx is the context-aware variant of Capture
+func (g *ModificationGateway) CaptureCtx(ctx context.Context, req CaptureRequest) (*ModificationResult, error) {
+	resp, err := g.adyen.executeIdempotentCtx(ctx, PaymentService, "capture", req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ModificationResult{}
+	if err := resp.Unmarshal(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
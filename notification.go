@@ -0,0 +1,147 @@
+package adyen
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Notification event codes Adyen sends in NotificationRequestItem.EventCode
+const (
+	EventCodeAuthorisation = "AUTHORISATION"
+	EventCodeCapture       = "CAPTURE"
+	EventCodeRefund        = "REFUND"
+	EventCodeChargeback    = "CHARGEBACK"
+)
+
+// NotificationRequest is the envelope Adyen posts to a merchant's notification endpoint
+type NotificationRequest struct {
+	Live              string                    `json:"live"`
+	NotificationItems []NotificationItemWrapper `json:"notificationItems"`
+}
+
+// NotificationItemWrapper wraps a single notification item, matching Adyen's JSON shape
+type NotificationItemWrapper struct {
+	NotificationRequestItem NotificationRequestItem `json:"NotificationRequestItem"`
+}
+
+// NotificationRequestItem is a single Adyen server-to-server notification
+type NotificationRequestItem struct {
+	PspReference        string            `json:"pspReference"`
+	OriginalReference   string            `json:"originalReference"`
+	MerchantAccountCode string            `json:"merchantAccountCode"`
+	MerchantReference   string            `json:"merchantReference"`
+	EventCode           string            `json:"eventCode"`
+	Success             string            `json:"success"`
+	Amount              Amount            `json:"amount"`
+	AdditionalData      map[string]string `json:"additionalData"`
+}
+
+// escapeNotificationField escapes backslashes and colons, as required before joining
+// NotificationRequestItem fields into the HMAC signing string
+func escapeNotificationField(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `:`, `\:`)
+	return s
+}
+
+// signingString builds the colon-separated string Adyen signs for a notification item:
+// pspReference:originalReference:merchantAccountCode:merchantReference:value:currency:eventCode:success
+func signingString(item NotificationRequestItem) string {
+	fields := []string{
+		item.PspReference,
+		item.OriginalReference,
+		item.MerchantAccountCode,
+		item.MerchantReference,
+		strconv.FormatInt(item.Amount.Value, 10),
+		item.Amount.Currency,
+		item.EventCode,
+		item.Success,
+	}
+
+	escaped := make([]string, len(fields))
+	for i, field := range fields {
+		escaped[i] = escapeNotificationField(field)
+	}
+
+	return strings.Join(escaped, ":")
+}
+
+// VerifyNotification validates a notification item's additionalData.hmacSignature against
+// the HMAC-SHA256 computed from the item's fields using hmacKeyHex, a hex-encoded HMAC key.
+func VerifyNotification(item NotificationRequestItem, hmacKeyHex string) error {
+	key, err := hex.DecodeString(hmacKeyHex)
+	if err != nil {
+		return fmt.Errorf("adyen: invalid hmac key: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signingString(item)))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	actual := item.AdditionalData["hmacSignature"]
+	if !hmac.Equal([]byte(expected), []byte(actual)) {
+		return fmt.Errorf("adyen: notification hmac signature mismatch")
+	}
+
+	return nil
+}
+
+// NotificationHandler verifies incoming Adyen notifications and dispatches verified
+// items to callbacks registered per eventCode
+type NotificationHandler struct {
+	HMACKey   string
+	callbacks map[string]func(NotificationRequestItem)
+}
+
+// Notification returns a NotificationHandler that verifies notifications using hmacKey,
+// a hex-encoded HMAC key generated in the Adyen Customer Area
+func (a *Adyen) Notification(hmacKey string) *NotificationHandler {
+	return NewNotificationHandler(hmacKey)
+}
+
+// NewNotificationHandler creates a NotificationHandler that verifies notifications using
+// hmacKey, a hex-encoded HMAC key generated in the Adyen Customer Area
+func NewNotificationHandler(hmacKey string) *NotificationHandler {
+	return &NotificationHandler{
+		HMACKey:   hmacKey,
+		callbacks: make(map[string]func(NotificationRequestItem)),
+	}
+}
+
+// OnEventCode registers a callback invoked for verified notification items matching eventCode
+// (e.g. EventCodeAuthorisation, EventCodeCapture, EventCodeRefund, EventCodeChargeback)
+func (h *NotificationHandler) OnEventCode(eventCode string, callback func(NotificationRequestItem)) {
+	h.callbacks[eventCode] = callback
+}
+
+// ServeHTTP implements http.Handler, verifying every notification item against HMACKey and
+// dispatching verified items to their registered callback. Unverified items, and bodies
+// that are not a valid NotificationRequest, are dropped.
+// It always responds with "[accepted]", as required by Adyen's notification contract.
+func (h *NotificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req NotificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.Write([]byte("[accepted]"))
+		return
+	}
+
+	for _, wrapper := range req.NotificationItems {
+		item := wrapper.NotificationRequestItem
+		if err := VerifyNotification(item, h.HMACKey); err != nil {
+			continue
+		}
+
+		if callback, ok := h.callbacks[item.EventCode]; ok {
+			callback(item)
+		}
+	}
+
+	w.Write([]byte("[accepted]"))
+}
@@ -0,0 +1,78 @@
+package adyen
+
+import "testing"
+
+// testHMACKey used across these tests, computed independently (Python hmac/hashlib) against
+// the field values below to produce known-answer signatures.
+const testHMACKey = "44782D1EA5F86C2B92A4FAEAB92B6D7C44782D1EA5F86C2B92A4FAEAB92B6D7C"
+
+func TestVerifyNotificationKnownAnswer(t *testing.T) {
+	item := NotificationRequestItem{
+		PspReference:        "8835633284781987",
+		MerchantAccountCode: "TestMerchant",
+		MerchantReference:   "merchant-ref-1",
+		EventCode:           EventCodeAuthorisation,
+		Success:             "true",
+		Amount:              Amount{Currency: "EUR", Value: 1000},
+		AdditionalData: map[string]string{
+			"hmacSignature": "0fcNUuRuSwWuFROBd7S6/IQbpMWDAnNf8cWuvTLWkh8=",
+		},
+	}
+
+	if err := VerifyNotification(item, testHMACKey); err != nil {
+		t.Fatalf("expected valid signature, got error: %v", err)
+	}
+}
+
+func TestVerifyNotificationEscapesColonsAndBackslashes(t *testing.T) {
+	item := NotificationRequestItem{
+		PspReference:        "8835633284781987",
+		OriginalReference:   "8835633284781988",
+		MerchantAccountCode: "TestMerchant",
+		MerchantReference:   `order:123\special`,
+		EventCode:           EventCodeCapture,
+		Success:             "true",
+		Amount:              Amount{Currency: "USD", Value: 500},
+		AdditionalData: map[string]string{
+			"hmacSignature": "ZLNYXwoQ56eNnFvnsbeboca0SBA7g9u0vvjRQtHhEyM=",
+		},
+	}
+
+	if err := VerifyNotification(item, testHMACKey); err != nil {
+		t.Fatalf("expected valid signature, got error: %v", err)
+	}
+}
+
+func TestVerifyNotificationRejectsTamperedField(t *testing.T) {
+	item := NotificationRequestItem{
+		PspReference:        "8835633284781987",
+		MerchantAccountCode: "TestMerchant",
+		MerchantReference:   "merchant-ref-1",
+		EventCode:           EventCodeAuthorisation,
+		Success:             "true",
+		Amount:              Amount{Currency: "EUR", Value: 1000},
+		AdditionalData: map[string]string{
+			"hmacSignature": "0fcNUuRuSwWuFROBd7S6/IQbpMWDAnNf8cWuvTLWkh8=",
+		},
+	}
+
+	// Tamper with the amount after the signature was computed, as an attacker would.
+	item.Amount.Value = 999999
+
+	if err := VerifyNotification(item, testHMACKey); err == nil {
+		t.Fatal("expected signature mismatch for tampered amount, got nil error")
+	}
+}
+
+func TestVerifyNotificationRejectsInvalidHexKey(t *testing.T) {
+	item := NotificationRequestItem{
+		EventCode: EventCodeAuthorisation,
+		AdditionalData: map[string]string{
+			"hmacSignature": "irrelevant",
+		},
+	}
+
+	if err := VerifyNotification(item, "not-hex"); err == nil {
+		t.Fatal("expected error decoding a non-hex hmac key")
+	}
+}
@@ -0,0 +1,41 @@
+package adyen
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures an Adyen instance at construction time
+type Option func(*Adyen)
+
+// WithTimeout sets ClientTimeout, used to build the default HTTPClient when one isn't
+// set via WithHTTPClient
+func WithTimeout(timeout time.Duration) Option {
+	return func(a *Adyen) {
+		a.ClientTimeout = timeout
+	}
+}
+
+// WithCurrency overrides DefaultCurrency for this instance
+func WithCurrency(currency string) Option {
+	return func(a *Adyen) {
+		a.Currency = currency
+	}
+}
+
+// WithLiveURLPrefix sets the merchant-specific URL prefix required when calling
+// the Live environment (e.g. "https://{prefix}-pal-live.adyenpayments.com").
+// It has no effect against the Testing environment.
+func WithLiveURLPrefix(prefix string) Option {
+	return func(a *Adyen) {
+		a.Credentials.LiveURLPrefix = prefix
+	}
+}
+
+// WithHTTPClient sets the http.Client used to perform requests, overriding the
+// default client built from ClientTimeout
+func WithHTTPClient(client *http.Client) Option {
+	return func(a *Adyen) {
+		a.HTTPClient = client
+	}
+}
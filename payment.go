@@ -0,0 +1,44 @@
+package adyen
+
+import "context"
+
+// PaymentGateway exposes the classic PAL Payment service operations
+type PaymentGateway struct {
+	adyen *Adyen
+}
+
+// PaymentRequest is the payload for PaymentGateway.Authorise
+type PaymentRequest struct {
+	MerchantAccount string `json:"merchantAccount"`
+	Amount          Amount `json:"amount"`
+	Reference       string `json:"reference"`
+	Card            *Card  `json:"card,omitempty"`
+}
+
+// PaymentResult is the response of PaymentGateway.Authorise
+type PaymentResult struct {
+	PspReference  string `json:"pspReference"`
+	ResultCode    string `json:"resultCode"`
+	RefusalReason string `json:"refusalReason,omitempty"`
+}
+
+// Authorise submits a payment authorisation request. The request carries an
+// auto-generated Idempotency-Key, so transient failures are safely retried.
+func (g *PaymentGateway) Authorise(req PaymentRequest) (*PaymentResult, error) {
+	return g.AuthoriseCtx(context.Background(), req)
+}
+
+// AuthoriseCtx is the context-aware variant of Authorise
+func (g *PaymentGateway) AuthoriseCtx(ctx context.Context, req PaymentRequest) (*PaymentResult, error) {
+	resp, err := g.adyen.executeIdempotentCtx(ctx, PaymentService, "authorise", req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PaymentResult{}
+	if err := resp.Unmarshal(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
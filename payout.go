@@ -0,0 +1,100 @@
+package adyen
+
+import "context"
+
+// PayoutGateway exposes the Payout service, used to disburse funds to recurring-stored
+// shoppers. Calls authenticate with apiCredentials.PayoutUsername/PayoutPassword, falling
+// back to the main Username/Password and then to the main APIKey when those are unset.
+type PayoutGateway struct {
+	adyen *Adyen
+}
+
+// StoreDetailAndSubmitThirdPartyRequest is the payload for
+// PayoutGateway.StoreDetailAndSubmitThirdParty
+type StoreDetailAndSubmitThirdPartyRequest struct {
+	MerchantAccount  string `json:"merchantAccount"`
+	ShopperReference string `json:"shopperReference"`
+	ShopperEmail     string `json:"shopperEmail,omitempty"`
+	Reference        string `json:"reference"`
+	Amount           Amount `json:"amount"`
+	Card             *Card  `json:"card,omitempty"`
+}
+
+// PayoutResult is the response of a Payout service call
+type PayoutResult struct {
+	PspReference string `json:"pspReference"`
+	ResultCode   string `json:"resultCode"`
+}
+
+// StoreDetailAndSubmitThirdParty stores a shopper's payout details and submits the payout
+// in a single call
+func (g *PayoutGateway) StoreDetailAndSubmitThirdParty(req StoreDetailAndSubmitThirdPartyRequest) (*PayoutResult, error) {
+	return g.StoreDetailAndSubmitThirdPartyCtx(context.Background(), req)
+}
+
+// StoreDetailAndSubmitThirdPartyCtx is the context-aware variant of StoreDetailAndSubmitThirdParty
+func (g *PayoutGateway) StoreDetailAndSubmitThirdPartyCtx(ctx context.Context, req StoreDetailAndSubmitThirdPartyRequest) (*PayoutResult, error) {
+	resp, err := g.adyen.executePayoutCtx(ctx, PayoutAPIVersion, "storeDetailAndSubmitThirdParty", req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PayoutResult{}
+	if err := resp.Unmarshal(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ConfirmThirdPartyRequest is the payload for PayoutGateway.ConfirmThirdParty
+type ConfirmThirdPartyRequest struct {
+	MerchantAccount   string `json:"merchantAccount"`
+	OriginalReference string `json:"originalReference"`
+}
+
+// ConfirmThirdParty confirms a previously submitted payout, releasing the funds
+func (g *PayoutGateway) ConfirmThirdParty(req ConfirmThirdPartyRequest) (*PayoutResult, error) {
+	return g.ConfirmThirdPartyCtx(context.Background(), req)
+}
+
+// ConfirmThirdPartyCtx is the context-aware variant of ConfirmThirdParty
+func (g *PayoutGateway) ConfirmThirdPartyCtx(ctx context.Context, req ConfirmThirdPartyRequest) (*PayoutResult, error) {
+	resp, err := g.adyen.executePayoutCtx(ctx, PayoutAPIVersion, "confirmThirdParty", req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PayoutResult{}
+	if err := resp.Unmarshal(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeclineThirdPartyRequest is the payload for PayoutGateway.DeclineThirdParty
+type DeclineThirdPartyRequest struct {
+	MerchantAccount   string `json:"merchantAccount"`
+	OriginalReference string `json:"originalReference"`
+}
+
+// DeclineThirdParty declines a previously submitted payout
+func (g *PayoutGateway) DeclineThirdParty(req DeclineThirdPartyRequest) (*PayoutResult, error) {
+	return g.DeclineThirdPartyCtx(context.Background(), req)
+}
+
+// DeclineThirdPartyCtx is the context-aware variant of DeclineThirdParty
+func (g *PayoutGateway) DeclineThirdPartyCtx(ctx context.Context, req DeclineThirdPartyRequest) (*PayoutResult, error) {
+	resp, err := g.adyen.executePayoutCtx(ctx, PayoutAPIVersion, "declineThirdParty", req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PayoutResult{}
+	if err := resp.Unmarshal(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,45 @@
+package adyen
+
+import "context"
+
+// RecurringGateway exposes the classic PAL Recurring service operations
+type RecurringGateway struct {
+	adyen *Adyen
+}
+
+// ListRecurringDetailsRequest is the payload for RecurringGateway.ListRecurringDetails
+type ListRecurringDetailsRequest struct {
+	MerchantAccount  string `json:"merchantAccount"`
+	ShopperReference string `json:"shopperReference"`
+	Recurring        struct {
+		Contract string `json:"contract"`
+	} `json:"recurring"`
+}
+
+// RecurringDetailsResult is the response of RecurringGateway.ListRecurringDetails
+type RecurringDetailsResult struct {
+	ShopperReference string `json:"shopperReference"`
+	Details          []struct {
+		RecurringDetailReference string `json:"recurringDetailReference"`
+	} `json:"details"`
+}
+
+// ListRecurringDetails fetches the stored recurring contracts for a shopper
+func (g *RecurringGateway) ListRecurringDetails(req ListRecurringDetailsRequest) (*RecurringDetailsResult, error) {
+	return g.ListRecurringDetailsCtx(context.Background(), req)
+}
+
+// ListRecurringDetailsCtx is the context-aware variant of ListRecurringDetails
+func (g *RecurringGateway) ListRecurringDetailsCtx(ctx context.Context, req ListRecurringDetailsRequest) (*RecurringDetailsResult, error) {
+	resp, err := g.adyen.executeCtx(ctx, RecurringService, "listRecurringDetails", req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RecurringDetailsResult{}
+	if err := resp.Unmarshal(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
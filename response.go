@@ -0,0 +1,26 @@
+package adyen
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Response wraps the raw Adyen HTTP response together with its body
+type Response struct {
+	*http.Response
+	Body []byte
+}
+
+// handleHTTPError returns an error when Adyen responded with a non-2xx status code
+func (r *Response) handleHTTPError() error {
+	if r.StatusCode >= 200 && r.StatusCode < 300 {
+		return nil
+	}
+	return fmt.Errorf("adyen: unexpected status code %d: %s", r.StatusCode, string(r.Body))
+}
+
+// Unmarshal decodes the JSON response body into the given destination
+func (r *Response) Unmarshal(v interface{}) error {
+	return json.Unmarshal(r.Body, v)
+}
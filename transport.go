@@ -0,0 +1,156 @@
+package adyen
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	mrand "math/rand"
+	"net/http"
+	"time"
+)
+
+// DefaultMaxRetries is used when Adyen.MaxRetries is not set
+const DefaultMaxRetries = 3
+
+// TransportError wraps a network-level error encountered while talking to Adyen,
+// once all retry attempts have been exhausted
+type TransportError struct {
+	Err      error
+	Attempts int
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("adyen: request failed after %d attempt(s): %v", e.Attempts, e.Err)
+}
+
+// Unwrap exposes the underlying network error for errors.Is/errors.As
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// httpClient returns the configured HTTPClient, falling back to one built from ClientTimeout
+func (a *Adyen) httpClient() *http.Client {
+	if a.HTTPClient != nil {
+		return a.HTTPClient
+	}
+	return &http.Client{Timeout: a.ClientTimeout}
+}
+
+// maxRetries returns the configured retry budget, falling back to DefaultMaxRetries
+func (a *Adyen) maxRetries() int {
+	if a.MaxRetries > 0 {
+		return a.MaxRetries
+	}
+	return DefaultMaxRetries
+}
+
+// newIdempotencyKey generates a random key suitable for the Idempotency-Key header
+func newIdempotencyKey() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// retryableStatus reports whether a response status code warrants a retry
+func retryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// backoff computes an exponential backoff duration with jitter for the given attempt (0-indexed)
+func backoff(attempt int) time.Duration {
+	base := time.Duration(math.Pow(2, float64(attempt))) * 100 * time.Millisecond
+	return base + time.Duration(mrand.Int63n(int64(base)+1))
+}
+
+// basicAuth overrides the Basic Auth credentials doRequest would otherwise take from
+// Adyen.Credentials, used by the Payout service's separate review-user account
+type basicAuth struct {
+	Username string
+	Password string
+}
+
+// doRequest performs a POST request with a JSON body against url, retrying requests that
+// carry an idempotencyKey on 429/5xx responses with exponential backoff. Requests without
+// an idempotencyKey are attempted once, matching execute's historical behaviour. auth is
+// nil unless the calling service authenticates with credentials other than Adyen.Credentials.
+func (a *Adyen) doRequest(ctx context.Context, url string, logLabel string, requestEntity interface{}, idempotencyKey string, auth *basicAuth) (*Response, error) {
+	body, err := json.Marshal(requestEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := 0
+
+	for {
+		attempts++
+
+		providerResponse, err := a.attempt(ctx, url, logLabel, body, idempotencyKey, auth)
+		if err != nil {
+			if idempotencyKey == "" || attempts >= a.maxRetries() {
+				return nil, &TransportError{Err: err, Attempts: attempts}
+			}
+		} else {
+			if idempotencyKey == "" || !retryableStatus(providerResponse.StatusCode) || attempts >= a.maxRetries() {
+				if err := providerResponse.handleHTTPError(); err != nil {
+					return nil, err
+				}
+				return providerResponse, nil
+			}
+		}
+
+		select {
+		case <-time.After(backoff(attempts - 1)):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// attempt performs a single HTTP round-trip for doRequest
+func (a *Adyen) attempt(ctx context.Context, url string, logLabel string, body []byte, idempotencyKey string, auth *basicAuth) (*Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	if auth != nil {
+		// A caller-supplied auth override (e.g. Payout's review-user credentials)
+		// always takes precedence over the main credentials, API key included.
+		req.SetBasicAuth(auth.Username, auth.Password)
+	} else if a.Credentials.APIKey != "" {
+		req.Header.Set("X-API-Key", a.Credentials.APIKey)
+	} else {
+		req.SetBasicAuth(a.Credentials.Username, a.Credentials.Password)
+	}
+
+	if a.Logger != nil {
+		a.Logger.Printf("[Request]: %s %s\n%s", logLabel, url, body)
+	}
+
+	resp, err := a.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	buf := new(bytes.Buffer)
+	if _, err := buf.ReadFrom(resp.Body); err != nil {
+		return nil, err
+	}
+
+	if a.Logger != nil {
+		a.Logger.Printf("[Response]: %s %s\n%s", logLabel, url, buf.String())
+	}
+
+	return &Response{Response: resp, Body: buf.Bytes()}, nil
+}
@@ -0,0 +1,106 @@
+package adyen
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDoRequestRetriesIdempotentRequestOnServerError(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	a := &Adyen{Credentials: newCredentials(Testing, "un", "pw"), MaxRetries: 3}
+
+	_, err := a.doRequest(context.Background(), server.URL, "test", map[string]string{}, "idem-key", nil)
+	if err != nil {
+		t.Fatalf("expected success after retries, got error: %v", err)
+	}
+	equals(t, 3, attempts)
+}
+
+func TestDoRequestSendsAPIKeyHeaderInsteadOfBasicAuth(t *testing.T) {
+	var gotAPIKey, gotAuthHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAPIKey = r.Header.Get("X-API-Key")
+		gotAuthHeader = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	a := &Adyen{Credentials: newCredentialsWithAPIKey(Testing, "test-api-key")}
+
+	_, err := a.doRequest(context.Background(), server.URL, "test", map[string]string{}, "", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	equals(t, "test-api-key", gotAPIKey)
+	equals(t, "", gotAuthHeader)
+}
+
+func TestDoRequestSendsBasicAuthWithoutAPIKey(t *testing.T) {
+	var gotAPIKey, gotAuthHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAPIKey = r.Header.Get("X-API-Key")
+		gotAuthHeader = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	a := &Adyen{Credentials: newCredentials(Testing, "un", "pw")}
+
+	_, err := a.doRequest(context.Background(), server.URL, "test", map[string]string{}, "", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	equals(t, "", gotAPIKey)
+	if gotAuthHeader == "" {
+		t.Fatal("expected a Basic Auth header to be sent")
+	}
+}
+
+func TestDoRequestDoesNotRetryWithoutIdempotencyKey(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	a := &Adyen{Credentials: newCredentials(Testing, "un", "pw"), MaxRetries: 3}
+
+	_, err := a.doRequest(context.Background(), server.URL, "test", map[string]string{}, "", nil)
+	if err == nil {
+		t.Fatal("expected error on 500 response")
+	}
+	equals(t, 1, attempts)
+}
+
+func TestDoRequestGivesUpAfterMaxRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	a := &Adyen{Credentials: newCredentials(Testing, "un", "pw"), MaxRetries: 2}
+
+	_, err := a.doRequest(context.Background(), server.URL, "test", map[string]string{}, "idem-key", nil)
+	if err == nil {
+		t.Fatal("expected error after exhausting retries")
+	}
+	equals(t, 2, attempts)
+}
@@ -0,0 +1,16 @@
+package adyen
+
+// Amount represents a monetary value in Adyen's minor units
+type Amount struct {
+	Currency string `json:"currency"`
+	Value    int64  `json:"value"`
+}
+
+// Card holds card details used to authorise a payment
+type Card struct {
+	Number      string `json:"number,omitempty"`
+	ExpiryMonth string `json:"expiryMonth,omitempty"`
+	ExpiryYear  string `json:"expiryYear,omitempty"`
+	HolderName  string `json:"holderName,omitempty"`
+	CVC         string `json:"cvc,omitempty"`
+}